@@ -0,0 +1,214 @@
+/*
+Copyright 2014 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPathStep is a single step of a parsed JSONPath expression, applied in sequence to
+// narrow down a set of candidate values.
+type jsonPathStep struct {
+	field       string // set for .field and ['key'] steps
+	recursive   bool   // true for a ..field step: search the whole subtree for field
+	isIndex     bool   // set for a [N] step
+	index       int
+	isWildcard  bool // set for a [*] step
+	isSlice     bool // set for a [start:end] step
+	sliceStart  int
+	sliceEnd    int
+	hasSliceEnd bool
+}
+
+// parseJSONPath parses expressions like ".items[*].metadata.name" into a sequence of steps.
+// The expression may optionally be wrapped in the "{...}" braces used on the command line,
+// e.g. `-o jsonpath='{.items[*].metadata.name}'`.
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	expr := strings.TrimSpace(path)
+	expr = strings.TrimPrefix(expr, "{")
+	expr = strings.TrimSuffix(expr, "}")
+
+	var steps []jsonPathStep
+	i, n := 0, len(expr)
+	for i < n {
+		switch expr[i] {
+		case '.':
+			i++
+			recursive := false
+			if i < n && expr[i] == '.' {
+				recursive = true
+				i++
+			}
+			start := i
+			for i < n && expr[i] != '.' && expr[i] != '[' {
+				i++
+			}
+			field := expr[start:i]
+			if field == "" && !recursive {
+				continue
+			}
+			steps = append(steps, jsonPathStep{field: field, recursive: recursive})
+		case '[':
+			end := strings.IndexByte(expr[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated [ in jsonpath expression %q", path)
+			}
+			inner := expr[i+1 : i+end]
+			i += end + 1
+			step, err := parseBracketStep(inner, path)
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, step)
+		default:
+			return nil, fmt.Errorf("unexpected character %q in jsonpath expression %q", expr[i], path)
+		}
+	}
+	return steps, nil
+}
+
+func parseBracketStep(inner, path string) (jsonPathStep, error) {
+	switch {
+	case inner == "*":
+		return jsonPathStep{isWildcard: true}, nil
+	case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+		return jsonPathStep{field: inner[1 : len(inner)-1]}, nil
+	case strings.Contains(inner, ":"):
+		parts := strings.SplitN(inner, ":", 2)
+		step := jsonPathStep{isSlice: true}
+		if parts[0] != "" {
+			v, err := strconv.Atoi(parts[0])
+			if err != nil {
+				return jsonPathStep{}, fmt.Errorf("invalid slice start in jsonpath expression %q: %v", path, err)
+			}
+			step.sliceStart = v
+		}
+		if parts[1] != "" {
+			v, err := strconv.Atoi(parts[1])
+			if err != nil {
+				return jsonPathStep{}, fmt.Errorf("invalid slice end in jsonpath expression %q: %v", path, err)
+			}
+			step.sliceEnd = v
+			step.hasSliceEnd = true
+		}
+		return step, nil
+	default:
+		v, err := strconv.Atoi(inner)
+		if err != nil {
+			return jsonPathStep{}, fmt.Errorf("invalid index in jsonpath expression %q: %v", path, err)
+		}
+		return jsonPathStep{isIndex: true, index: v}, nil
+	}
+}
+
+// evalJSONPath applies steps in sequence to data, returning every value they match.
+func evalJSONPath(steps []jsonPathStep, data interface{}) ([]interface{}, error) {
+	values := []interface{}{data}
+	for _, step := range steps {
+		var next []interface{}
+		for _, v := range values {
+			matched, err := applyJSONPathStep(step, v)
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, matched...)
+		}
+		values = next
+	}
+	return values, nil
+}
+
+func applyJSONPathStep(step jsonPathStep, data interface{}) ([]interface{}, error) {
+	if step.recursive {
+		var collected []interface{}
+		collectRecursiveField(data, step.field, &collected)
+		return collected, nil
+	}
+	switch {
+	case step.isWildcard:
+		return jsonPathChildren(data)
+	case step.isSlice:
+		list, ok := data.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("slice step applied to non-list value %#v", data)
+		}
+		end := len(list)
+		if step.hasSliceEnd {
+			end = step.sliceEnd
+		}
+		if step.sliceStart < 0 || end > len(list) || step.sliceStart > end {
+			return nil, fmt.Errorf("slice [%d:%d] out of range for list of length %d", step.sliceStart, end, len(list))
+		}
+		out := make([]interface{}, 0, end-step.sliceStart)
+		out = append(out, list[step.sliceStart:end]...)
+		return out, nil
+	case step.isIndex:
+		list, ok := data.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("index step applied to non-list value %#v", data)
+		}
+		if step.index < 0 || step.index >= len(list) {
+			return nil, fmt.Errorf("index %d out of range for list of length %d", step.index, len(list))
+		}
+		return []interface{}{list[step.index]}, nil
+	default:
+		m, ok := data.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("field %q applied to non-object value %#v", step.field, data)
+		}
+		v, ok := m[step.field]
+		if !ok {
+			return nil, nil
+		}
+		return []interface{}{v}, nil
+	}
+}
+
+func jsonPathChildren(data interface{}) ([]interface{}, error) {
+	switch t := data.(type) {
+	case []interface{}:
+		return t, nil
+	case map[string]interface{}:
+		out := make([]interface{}, 0, len(t))
+		for _, v := range t {
+			out = append(out, v)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("wildcard applied to scalar value %#v", data)
+	}
+}
+
+// collectRecursiveField walks data depth-first, appending the value of every field matching
+// name found anywhere in the tree.
+func collectRecursiveField(data interface{}, name string, out *[]interface{}) {
+	switch t := data.(type) {
+	case map[string]interface{}:
+		if v, ok := t[name]; ok {
+			*out = append(*out, v)
+		}
+		for _, v := range t {
+			collectRecursiveField(v, name, out)
+		}
+	case []interface{}:
+		for _, v := range t {
+			collectRecursiveField(v, name, out)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2014 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// podList mimics the shape of a versioned map produced by toVersionedMap for a PodList,
+// with nested containers and a label map, so the cases below exercise the same structure
+// JSONPathPrinter evaluates against in practice.
+var podList = map[string]interface{}{
+	"kind": "PodList",
+	"items": []interface{}{
+		map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name": "nginx",
+				"labels": map[string]interface{}{
+					"app":    "nginx",
+					"tier:x": "frontend",
+				},
+			},
+			"spec": map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{"name": "nginx", "image": "nginx:latest"},
+				},
+			},
+		},
+		map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name": "redis",
+				"labels": map[string]interface{}{
+					"app": "redis",
+				},
+			},
+			"spec": map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{"name": "redis", "image": "redis:latest"},
+					map[string]interface{}{"name": "sidecar", "image": "sidecar:latest"},
+				},
+			},
+		},
+	},
+}
+
+func evalPath(t *testing.T, path string, data interface{}) []interface{} {
+	steps, err := parseJSONPath(path)
+	if err != nil {
+		t.Fatalf("parseJSONPath(%q) returned error: %v", path, err)
+	}
+	values, err := evalJSONPath(steps, data)
+	if err != nil {
+		t.Fatalf("evalJSONPath(%q) returned error: %v", path, err)
+	}
+	return values
+}
+
+func TestJSONPathPodListNames(t *testing.T) {
+	got := evalPath(t, "{.items[*].metadata.name}", podList)
+	want := []interface{}{"nginx", "redis"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("items[*].metadata.name = %#v, want %#v", got, want)
+	}
+}
+
+func TestJSONPathNestedContainers(t *testing.T) {
+	got := evalPath(t, "{.items[*].spec.containers[*].image}", podList)
+	want := []interface{}{"nginx:latest", "redis:latest", "sidecar:latest"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("items[*].spec.containers[*].image = %#v, want %#v", got, want)
+	}
+}
+
+func TestJSONPathContainerIndex(t *testing.T) {
+	got := evalPath(t, "{.items[1].spec.containers[1].name}", podList)
+	want := []interface{}{"sidecar"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("items[1].spec.containers[1].name = %#v, want %#v", got, want)
+	}
+}
+
+func TestJSONPathLabelMap(t *testing.T) {
+	got := evalPath(t, "{.items[0].metadata.labels.app}", podList)
+	want := []interface{}{"nginx"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("items[0].metadata.labels.app = %#v, want %#v", got, want)
+	}
+}
+
+// TestJSONPathQuotedLabelKeyWithColon guards against a parser bug where a bracketed key
+// containing a literal ':' (a perfectly ordinary label key, e.g. "tier:x") was misparsed
+// as a slice expression instead of a quoted field access.
+func TestJSONPathQuotedLabelKeyWithColon(t *testing.T) {
+	got := evalPath(t, "{.items[0].metadata.labels['tier:x']}", podList)
+	want := []interface{}{"frontend"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("items[0].metadata.labels['tier:x'] = %#v, want %#v", got, want)
+	}
+}
+
+// TestJSONPathRecursiveName descends through maps in map-range order, so it only asserts on
+// the multiset of matches, not their order.
+func TestJSONPathRecursiveName(t *testing.T) {
+	got := evalPath(t, "{..name}", podList)
+	gotStrs := make([]string, len(got))
+	for i, v := range got {
+		gotStrs[i] = v.(string)
+	}
+	sort.Strings(gotStrs)
+
+	want := []string{"nginx", "nginx", "redis", "redis", "sidecar"}
+	if !reflect.DeepEqual(gotStrs, want) {
+		t.Errorf("..name = %#v, want %#v (order-independent)", gotStrs, want)
+	}
+}
@@ -40,6 +40,8 @@ import (
 func GetPrinter(version, format, templateFile string, defaultPrinter ResourcePrinter) (ResourcePrinter, error) {
 	var printer ResourcePrinter
 	switch format {
+	case "name":
+		printer = &NamePrinter{}
 	case "json":
 		printer = &JSONPrinter{version}
 	case "yaml":
@@ -65,6 +67,48 @@ func GetPrinter(version, format, templateFile string, defaultPrinter ResourcePri
 		if err != nil {
 			return nil, fmt.Errorf("error parsing template %s, %v\n", string(data), err)
 		}
+	case "jsonpath":
+		if len(templateFile) == 0 {
+			return nil, fmt.Errorf("jsonpath format specified but no template given")
+		}
+		var err error
+		printer, err = NewJSONPathPrinter(version, templateFile)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing jsonpath %s, %v\n", templateFile, err)
+		}
+	case "jsonpath-file":
+		if len(templateFile) == 0 {
+			return nil, fmt.Errorf("jsonpath-file format specified but no template file given")
+		}
+		data, err := ioutil.ReadFile(templateFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading template %s, %v\n", templateFile, err)
+		}
+		printer, err = NewJSONPathPrinter(version, string(data))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing jsonpath %s, %v\n", string(data), err)
+		}
+	case "custom-columns":
+		if len(templateFile) == 0 {
+			return nil, fmt.Errorf("custom-columns format specified but no spec given")
+		}
+		var err error
+		printer, err = NewCustomColumnsPrinter(version, templateFile)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing custom-columns spec %s, %v\n", templateFile, err)
+		}
+	case "custom-columns-file":
+		if len(templateFile) == 0 {
+			return nil, fmt.Errorf("custom-columns-file format specified but no spec file given")
+		}
+		data, err := ioutil.ReadFile(templateFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom-columns spec %s, %v\n", templateFile, err)
+		}
+		printer, err = NewCustomColumnsPrinter(version, string(data))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing custom-columns spec %s, %v\n", string(data), err)
+		}
 	case "":
 		printer = defaultPrinter
 	default:
@@ -149,9 +193,71 @@ func (y *YAMLPrinter) PrintObj(obj runtime.Object, w io.Writer) error {
 // IsVersioned returns true.
 func (*YAMLPrinter) IsVersioned() bool { return true }
 
+// NamePrinter is an implementation of ResourcePrinter which prints only the resource's
+// kind and name, e.g. "pod/nginx". It requires no per-type handler registration: the kind
+// is taken from the object's Go type and the name from its ObjectMeta. List kinds are
+// expanded by walking their Items via reflection, so `kubectl get pods -o name` prints one
+// line per pod rather than a single "podlist/" line.
+type NamePrinter struct{}
+
+// PrintObj is an implementation of ResourcePrinter.PrintObj which prints "<kind>/<name>" for obj.
+func (p *NamePrinter) PrintObj(obj runtime.Object, w io.Writer) error {
+	value := reflect.ValueOf(obj)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return fmt.Errorf("error: unknown object %#v", obj)
+	}
+
+	kind := value.Type().Name()
+	if strings.HasSuffix(kind, "List") {
+		items := value.FieldByName("Items")
+		if !items.IsValid() {
+			return fmt.Errorf("error: unknown list object %#v", obj)
+		}
+		for i := 0; i < items.Len(); i++ {
+			item, ok := items.Index(i).Addr().Interface().(runtime.Object)
+			if !ok {
+				continue
+			}
+			if err := p.PrintObj(item, w); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	meta := value.FieldByName("ObjectMeta")
+	if !meta.IsValid() {
+		return fmt.Errorf("error: unknown object %#v", obj)
+	}
+	name := meta.FieldByName("Name").String()
+	_, err := fmt.Fprintf(w, "%s/%s\n", strings.ToLower(kind), name)
+	return err
+}
+
+// IsVersioned returns false -- name output does not depend on the object's API version.
+func (*NamePrinter) IsVersioned() bool { return false }
+
 type handlerEntry struct {
-	columns   []string
-	printFunc reflect.Value
+	columns     []string
+	wideColumns []string
+	printFunc   reflect.Value
+}
+
+// PrintOptions holds the display options for a HumanReadablePrinter.
+type PrintOptions struct {
+	// NoHeaders suppresses the column header row.
+	NoHeaders bool
+	// Wide enables extra, type-specific columns (e.g. node IP and ports for pods).
+	Wide bool
+	// ShowLabels appends a LABELS column containing the object's full label set.
+	ShowLabels bool
+	// WithNamespace prepends a NAMESPACE column.
+	WithNamespace bool
+	// ColumnLabels promotes the named label keys to their own columns.
+	ColumnLabels []string
 }
 
 // HumanReadablePrinter is an implementation of ResourcePrinter which attempts to provide
@@ -160,29 +266,31 @@ type handlerEntry struct {
 // recieved from watches.
 type HumanReadablePrinter struct {
 	handlerMap map[reflect.Type]*handlerEntry
-	noHeaders  bool
+	options    PrintOptions
 	lastType   reflect.Type
 }
 
 // IsVersioned returns false-- human readable printers do not make versioned output.
 func (*HumanReadablePrinter) IsVersioned() bool { return false }
 
-// NewHumanReadablePrinter creates a HumanReadablePrinter.
-func NewHumanReadablePrinter(noHeaders bool) *HumanReadablePrinter {
+// NewHumanReadablePrinter creates a HumanReadablePrinter configured by options.
+func NewHumanReadablePrinter(options PrintOptions) *HumanReadablePrinter {
 	printer := &HumanReadablePrinter{
 		handlerMap: make(map[reflect.Type]*handlerEntry),
-		noHeaders:  noHeaders,
+		options:    options,
 	}
 	printer.addDefaultHandlers()
 	return printer
 }
 
 // Handler adds a print handler with a given set of columns to HumanReadablePrinter instance.
-// printFunc is the function that will be called to print an object.
-// It must be of the following type:
-//  func printFunc(object ObjectType, w io.Writer) error
+// wideColumns, if any, are only shown when PrintOptions.Wide is set. printFunc is the
+// function that will be called to print an object. It must be of the following type:
+//
+//	func printFunc(object ObjectType, w io.Writer, options PrintOptions) error
+//
 // where ObjectType is the type of the object that will be printed.
-func (h *HumanReadablePrinter) Handler(columns []string, printFunc interface{}) error {
+func (h *HumanReadablePrinter) Handler(columns, wideColumns []string, printFunc interface{}) error {
 	printFuncValue := reflect.ValueOf(printFunc)
 	if err := h.validatePrintHandlerFunc(printFuncValue); err != nil {
 		glog.Errorf("Unable to add print handler: %v", err)
@@ -190,8 +298,9 @@ func (h *HumanReadablePrinter) Handler(columns []string, printFunc interface{})
 	}
 	objType := printFuncValue.Type().In(0)
 	h.handlerMap[objType] = &handlerEntry{
-		columns:   columns,
-		printFunc: printFuncValue,
+		columns:     columns,
+		wideColumns: wideColumns,
+		printFunc:   printFuncValue,
 	}
 	return nil
 }
@@ -201,19 +310,21 @@ func (h *HumanReadablePrinter) validatePrintHandlerFunc(printFunc reflect.Value)
 		return fmt.Errorf("invalid print handler. %#v is not a function.", printFunc)
 	}
 	funcType := printFunc.Type()
-	if funcType.NumIn() != 2 || funcType.NumOut() != 1 {
+	if funcType.NumIn() != 3 || funcType.NumOut() != 1 {
 		return fmt.Errorf("invalid print handler." +
-			"Must accept 2 parameters and return 1 value.")
+			"Must accept 3 parameters and return 1 value.")
 	}
 	if funcType.In(1) != reflect.TypeOf((*io.Writer)(nil)).Elem() ||
+		funcType.In(2) != reflect.TypeOf(PrintOptions{}) ||
 		funcType.Out(0) != reflect.TypeOf((*error)(nil)).Elem() {
 		return fmt.Errorf("invalid print handler. The expected signature is: "+
-			"func handler(obj %v, w io.Writer) error", funcType.In(0))
+			"func handler(obj %v, w io.Writer, options PrintOptions) error", funcType.In(0))
 	}
 	return nil
 }
 
 var podColumns = []string{"NAME", "IMAGE(S)", "HOST", "LABELS", "STATUS"}
+var podWideColumns = []string{"NODE IP", "PORTS"}
 var replicationControllerColumns = []string{"NAME", "IMAGE(S)", "SELECTOR", "REPLICAS"}
 var serviceColumns = []string{"NAME", "LABELS", "SELECTOR", "IP", "PORT"}
 var minionColumns = []string{"NAME"}
@@ -222,17 +333,17 @@ var eventColumns = []string{"NAME", "KIND", "STATUS", "REASON", "MESSAGE"}
 
 // addDefaultHandlers adds print handlers for default Kubernetes types.
 func (h *HumanReadablePrinter) addDefaultHandlers() {
-	h.Handler(podColumns, printPod)
-	h.Handler(podColumns, printPodList)
-	h.Handler(replicationControllerColumns, printReplicationController)
-	h.Handler(replicationControllerColumns, printReplicationControllerList)
-	h.Handler(serviceColumns, printService)
-	h.Handler(serviceColumns, printServiceList)
-	h.Handler(minionColumns, printMinion)
-	h.Handler(minionColumns, printMinionList)
-	h.Handler(statusColumns, printStatus)
-	h.Handler(eventColumns, printEvent)
-	h.Handler(eventColumns, printEventList)
+	h.Handler(podColumns, podWideColumns, printPod)
+	h.Handler(podColumns, podWideColumns, printPodList)
+	h.Handler(replicationControllerColumns, nil, printReplicationController)
+	h.Handler(replicationControllerColumns, nil, printReplicationControllerList)
+	h.Handler(serviceColumns, nil, printService)
+	h.Handler(serviceColumns, nil, printServiceList)
+	h.Handler(minionColumns, nil, printMinion)
+	h.Handler(minionColumns, nil, printMinionList)
+	h.Handler(statusColumns, nil, printStatus)
+	h.Handler(eventColumns, nil, printEvent)
+	h.Handler(eventColumns, nil, printEventList)
 }
 
 func (h *HumanReadablePrinter) unknown(data []byte, w io.Writer) error {
@@ -240,11 +351,60 @@ func (h *HumanReadablePrinter) unknown(data []byte, w io.Writer) error {
 	return err
 }
 
-func (h *HumanReadablePrinter) printHeader(columnNames []string, w io.Writer) error {
-	if _, err := fmt.Fprintf(w, "%s\n", strings.Join(columnNames, "\t")); err != nil {
-		return err
+// printHeader writes the column header row for handler, taking NAMESPACE, Wide, ColumnLabels
+// and ShowLabels into account.
+func (h *HumanReadablePrinter) printHeader(handler *handlerEntry, w io.Writer) error {
+	var columns []string
+	if h.options.WithNamespace {
+		columns = append(columns, "NAMESPACE")
 	}
-	return nil
+	columns = append(columns, handler.columns...)
+	if h.options.Wide {
+		columns = append(columns, handler.wideColumns...)
+	}
+	for _, label := range h.options.ColumnLabels {
+		columns = append(columns, formatLabelHeader(label))
+	}
+	if h.options.ShowLabels && !hasColumn(handler.columns, "LABELS") {
+		columns = append(columns, "LABELS")
+	}
+	_, err := fmt.Fprintf(w, "%s\n", strings.Join(columns, "\t"))
+	return err
+}
+
+// formatLabelHeader turns a label key into the header for its promoted column.
+func formatLabelHeader(label string) string {
+	return strings.ToUpper(label)
+}
+
+func hasColumn(columns []string, name string) bool {
+	for _, c := range columns {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// appendColumnLabelCells appends the ColumnLabels to values, matching the column layout
+// produced by printHeader. Used by types such as Pod and Service whose LABELS column is
+// always shown as part of their base columns, so ShowLabels would otherwise duplicate it.
+func appendColumnLabelCells(values []string, lbls labels.Set, options PrintOptions) []string {
+	for _, label := range options.ColumnLabels {
+		values = append(values, lbls[label])
+	}
+	return values
+}
+
+// appendLabelCells appends the ColumnLabels and, if ShowLabels is set, the full label set to
+// values, matching the column layout produced by printHeader. Used by types that have no
+// baked-in LABELS column of their own.
+func appendLabelCells(values []string, lbls labels.Set, options PrintOptions) []string {
+	values = appendColumnLabelCells(values, lbls, options)
+	if options.ShowLabels {
+		values = append(values, labels.Set(lbls).String())
+	}
+	return values
 }
 
 func podHostString(host, ip string) string {
@@ -254,94 +414,142 @@ func podHostString(host, ip string) string {
 	return host + "/" + ip
 }
 
-func printPod(pod *api.Pod, w io.Writer) error {
+func printPod(pod *api.Pod, w io.Writer, options PrintOptions) error {
 	// TODO: remove me when pods are converted
 	spec := &api.PodSpec{}
 	if err := api.Scheme.Convert(&pod.DesiredState.Manifest, spec); err != nil {
 		glog.Errorf("Unable to convert pod manifest: %v", err)
 	}
 
-	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
-		pod.Name, makeImageList(spec),
+	var values []string
+	if options.WithNamespace {
+		values = append(values, pod.Namespace)
+	}
+	values = append(values,
+		pod.Name,
+		makeImageList(spec),
 		podHostString(pod.CurrentState.Host, pod.CurrentState.HostIP),
-		labels.Set(pod.Labels), pod.CurrentState.Status)
+		labels.Set(pod.Labels).String(),
+		pod.CurrentState.Status,
+	)
+	if options.Wide {
+		values = append(values, pod.CurrentState.HostIP, makePortsList(spec))
+	}
+	values = appendColumnLabelCells(values, pod.Labels, options)
+	_, err := fmt.Fprintf(w, "%s\n", strings.Join(values, "\t"))
 	return err
 }
 
-func printPodList(podList *api.PodList, w io.Writer) error {
+func printPodList(podList *api.PodList, w io.Writer, options PrintOptions) error {
 	for _, pod := range podList.Items {
-		if err := printPod(&pod, w); err != nil {
+		if err := printPod(&pod, w, options); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func printReplicationController(controller *api.ReplicationController, w io.Writer) error {
-	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%d\n",
-		controller.Name, makeImageList(&controller.Spec.Template.Spec),
-		labels.Set(controller.Spec.Selector), controller.Spec.Replicas)
+func printReplicationController(controller *api.ReplicationController, w io.Writer, options PrintOptions) error {
+	var values []string
+	if options.WithNamespace {
+		values = append(values, controller.Namespace)
+	}
+	values = append(values,
+		controller.Name,
+		makeImageList(&controller.Spec.Template.Spec),
+		labels.Set(controller.Spec.Selector).String(),
+		fmt.Sprintf("%d", controller.Spec.Replicas),
+	)
+	values = appendLabelCells(values, controller.Labels, options)
+	_, err := fmt.Fprintf(w, "%s\n", strings.Join(values, "\t"))
 	return err
 }
 
-func printReplicationControllerList(list *api.ReplicationControllerList, w io.Writer) error {
+func printReplicationControllerList(list *api.ReplicationControllerList, w io.Writer, options PrintOptions) error {
 	for _, controller := range list.Items {
-		if err := printReplicationController(&controller, w); err != nil {
+		if err := printReplicationController(&controller, w, options); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func printService(svc *api.Service, w io.Writer) error {
-	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\n", svc.Name, labels.Set(svc.Labels),
-		labels.Set(svc.Spec.Selector), svc.Spec.PortalIP, svc.Spec.Port)
+func printService(svc *api.Service, w io.Writer, options PrintOptions) error {
+	var values []string
+	if options.WithNamespace {
+		values = append(values, svc.Namespace)
+	}
+	values = append(values,
+		svc.Name,
+		labels.Set(svc.Labels).String(),
+		labels.Set(svc.Spec.Selector).String(),
+		svc.Spec.PortalIP,
+		fmt.Sprintf("%d", svc.Spec.Port),
+	)
+	values = appendColumnLabelCells(values, svc.Labels, options)
+	_, err := fmt.Fprintf(w, "%s\n", strings.Join(values, "\t"))
 	return err
 }
 
-func printServiceList(list *api.ServiceList, w io.Writer) error {
+func printServiceList(list *api.ServiceList, w io.Writer, options PrintOptions) error {
 	for _, svc := range list.Items {
-		if err := printService(&svc, w); err != nil {
+		if err := printService(&svc, w, options); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func printMinion(minion *api.Minion, w io.Writer) error {
-	_, err := fmt.Fprintf(w, "%s\n", minion.Name)
+func printMinion(minion *api.Minion, w io.Writer, options PrintOptions) error {
+	var values []string
+	if options.WithNamespace {
+		values = append(values, minion.Namespace)
+	}
+	values = append(values, minion.Name)
+	values = appendLabelCells(values, minion.Labels, options)
+	_, err := fmt.Fprintf(w, "%s\n", strings.Join(values, "\t"))
 	return err
 }
 
-func printMinionList(list *api.MinionList, w io.Writer) error {
+func printMinionList(list *api.MinionList, w io.Writer, options PrintOptions) error {
 	for _, minion := range list.Items {
-		if err := printMinion(&minion, w); err != nil {
+		if err := printMinion(&minion, w, options); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func printStatus(status *api.Status, w io.Writer) error {
+func printStatus(status *api.Status, w io.Writer, options PrintOptions) error {
+	if options.WithNamespace {
+		// A Status has no namespace; leave the NAMESPACE cell empty so STATUS stays aligned.
+		_, err := fmt.Fprintf(w, "\t%v\n", status.Status)
+		return err
+	}
 	_, err := fmt.Fprintf(w, "%v\n", status.Status)
 	return err
 }
 
-func printEvent(event *api.Event, w io.Writer) error {
-	_, err := fmt.Fprintf(
-		w, "%s\t%s\t%s\t%s\t%s\n",
+func printEvent(event *api.Event, w io.Writer, options PrintOptions) error {
+	var values []string
+	if options.WithNamespace {
+		values = append(values, event.Namespace)
+	}
+	values = append(values,
 		event.InvolvedObject.Name,
 		event.InvolvedObject.Kind,
 		event.Status,
 		event.Reason,
 		event.Message,
 	)
+	values = appendLabelCells(values, event.Labels, options)
+	_, err := fmt.Fprintf(w, "%s\n", strings.Join(values, "\t"))
 	return err
 }
 
-func printEventList(list *api.EventList, w io.Writer) error {
+func printEventList(list *api.EventList, w io.Writer, options PrintOptions) error {
 	for i := range list.Items {
-		if err := printEvent(&list.Items[i], w); err != nil {
+		if err := printEvent(&list.Items[i], w, options); err != nil {
 			return err
 		}
 	}
@@ -352,13 +560,22 @@ func printEventList(list *api.EventList, w io.Writer) error {
 func (h *HumanReadablePrinter) PrintObj(obj runtime.Object, output io.Writer) error {
 	w := tabwriter.NewWriter(output, 20, 5, 3, ' ', 0)
 	defer w.Flush()
+	return h.printObject(obj, w)
+}
+
+// printObject prints a single obj to w, dispatching to a registered handler if one exists for
+// its type and falling back to printUnstructured otherwise. Unlike PrintObj, it does not
+// allocate its own tabwriter -- callers that need to print several objects in sequence, such as
+// printUnstructured's List handling, pass the same w through so the whole run shares one set of
+// column widths.
+func (h *HumanReadablePrinter) printObject(obj runtime.Object, w io.Writer) error {
 	t := reflect.TypeOf(obj)
 	if handler := h.handlerMap[t]; handler != nil {
-		if !h.noHeaders && t != h.lastType {
-			h.printHeader(handler.columns, w)
+		if !h.options.NoHeaders && t != h.lastType {
+			h.printHeader(handler, w)
 			h.lastType = t
 		}
-		args := []reflect.Value{reflect.ValueOf(obj), reflect.ValueOf(w)}
+		args := []reflect.Value{reflect.ValueOf(obj), reflect.ValueOf(w), reflect.ValueOf(h.options)}
 		resultValue := handler.printFunc.Call(args)[0]
 		if resultValue.IsNil() {
 			return nil
@@ -366,8 +583,65 @@ func (h *HumanReadablePrinter) PrintObj(obj runtime.Object, output io.Writer) er
 			return resultValue.Interface().(error)
 		}
 	} else {
+		return h.printUnstructured(obj, w)
+	}
+}
+
+// printUnstructured is a fallback for types with no registered handler. If the object's Go
+// type name ends in "List", its Items are walked and each element is printed recursively
+// through printObject, sharing w across the whole list so columns line up the same way
+// printPodList/printServiceList do -- so registered handlers still apply to the elements, e.g. an
+// unregistered list type whose Items are api.Pod still prints one pod row per item.
+// Anything else is rendered as a minimal NAME (and NAMESPACE, if requested) row pulled from
+// its ObjectMeta, so that third-party or future API types render instead of erroring out.
+func (h *HumanReadablePrinter) printUnstructured(obj runtime.Object, w io.Writer) error {
+	value := reflect.ValueOf(obj)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if !value.IsValid() {
 		return fmt.Errorf("error: unknown type %#v", obj)
 	}
+
+	if strings.HasSuffix(value.Type().Name(), "List") {
+		items := value.FieldByName("Items")
+		if !items.IsValid() {
+			return fmt.Errorf("error: unknown type %#v", obj)
+		}
+		for i := 0; i < items.Len(); i++ {
+			item, ok := items.Index(i).Addr().Interface().(runtime.Object)
+			if !ok {
+				continue
+			}
+			if err := h.printObject(item, w); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	meta := value.FieldByName("ObjectMeta")
+	if !meta.IsValid() {
+		return fmt.Errorf("error: unknown type %#v", obj)
+	}
+
+	if !h.options.NoHeaders && value.Type() != h.lastType {
+		header := []string{}
+		if h.options.WithNamespace {
+			header = append(header, "NAMESPACE")
+		}
+		header = append(header, "NAME")
+		fmt.Fprintf(w, "%s\n", strings.Join(header, "\t"))
+		h.lastType = value.Type()
+	}
+
+	row := []string{}
+	if h.options.WithNamespace {
+		row = append(row, meta.FieldByName("Namespace").String())
+	}
+	row = append(row, meta.FieldByName("Name").String())
+	_, err := fmt.Fprintf(w, "%s\n", strings.Join(row, "\t"))
+	return err
 }
 
 // TemplatePrinter is an implementation of ResourcePrinter which formats data with a Go Template.
@@ -396,6 +670,123 @@ func (t *TemplatePrinter) PrintObj(obj runtime.Object, w io.Writer) error {
 	return t.template.Execute(w, outObj)
 }
 
+// JSONPathPrinter is an implementation of ResourcePrinter which formats data with a JSONPath
+// expression, e.g. `-o jsonpath='{.items[*].metadata.name}'`. It supports `.field` and
+// `['key']` field access, `[index]` and `[start:end]` list access, `[*]` wildcards, and
+// `..field` recursive descent.
+type JSONPathPrinter struct {
+	version string
+	path    string
+	steps   []jsonPathStep
+}
+
+// NewJSONPathPrinter parses path and returns a printer that evaluates it against the
+// versioned representation of the objects it is asked to print.
+func NewJSONPathPrinter(version, path string) (*JSONPathPrinter, error) {
+	steps, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONPathPrinter{version, path, steps}, nil
+}
+
+// PrintObj formats the obj with the JSONPath expression, writing the matched scalars
+// separated by spaces followed by a trailing newline.
+func (j *JSONPathPrinter) PrintObj(obj runtime.Object, w io.Writer) error {
+	outObj, err := toVersionedMap(j.version, obj)
+	if err != nil {
+		return err
+	}
+	values, err := evalJSONPath(j.steps, outObj)
+	if err != nil {
+		return fmt.Errorf("error evaluating jsonpath %q: %v", j.path, err)
+	}
+	strs := make([]string, 0, len(values))
+	for _, v := range values {
+		strs = append(strs, fmt.Sprintf("%v", v))
+	}
+	_, err = fmt.Fprintf(w, "%s\n", strings.Join(strs, " "))
+	return err
+}
+
+// IsVersioned returns true.
+func (*JSONPathPrinter) IsVersioned() bool { return true }
+
+// CustomColumnsPrinter is an implementation of ResourcePrinter which formats data into a
+// tab-aligned table whose columns are driven by user-supplied field paths, e.g.
+// "NAME:.metadata.name,IMAGE:.spec.containers[*].image". It complements the hard-coded
+// column sets used by HumanReadablePrinter for ad-hoc `kubectl get` output.
+type CustomColumnsPrinter struct {
+	version string
+	headers []string
+	steps   [][]jsonPathStep
+}
+
+// NewCustomColumnsPrinter parses a spec of comma-separated "HEADER:path" pairs.
+func NewCustomColumnsPrinter(version, spec string) (*CustomColumnsPrinter, error) {
+	parts := strings.Split(spec, ",")
+	headers := make([]string, 0, len(parts))
+	steps := make([][]jsonPathStep, 0, len(parts))
+	for _, part := range parts {
+		pieces := strings.SplitN(part, ":", 2)
+		if len(pieces) != 2 {
+			return nil, fmt.Errorf("invalid custom-columns spec %q: expected HEADER:path", part)
+		}
+		path, err := parseJSONPath(pieces[1])
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, pieces[0])
+		steps = append(steps, path)
+	}
+	return &CustomColumnsPrinter{version, headers, steps}, nil
+}
+
+// IsVersioned returns true.
+func (*CustomColumnsPrinter) IsVersioned() bool { return true }
+
+// PrintObj prints obj as a tab-aligned table, with one row per item for a List kind (or a
+// single row otherwise) and one column per field path in the spec. Paths that match nothing
+// render as "<none>"; paths that match more than one value are joined with commas.
+func (p *CustomColumnsPrinter) PrintObj(obj runtime.Object, output io.Writer) error {
+	outObj, err := toVersionedMap(p.version, obj)
+	if err != nil {
+		return err
+	}
+
+	var rows []map[string]interface{}
+	if items, ok := outObj["items"].([]interface{}); ok {
+		for _, item := range items {
+			if row, ok := item.(map[string]interface{}); ok {
+				rows = append(rows, row)
+			}
+		}
+	} else {
+		rows = append(rows, outObj)
+	}
+
+	w := tabwriter.NewWriter(output, 20, 5, 3, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintf(w, "%s\n", strings.Join(p.headers, "\t"))
+	for _, row := range rows {
+		cells := make([]string, len(p.steps))
+		for i, steps := range p.steps {
+			values, err := evalJSONPath(steps, row)
+			if err != nil || len(values) == 0 {
+				cells[i] = "<none>"
+				continue
+			}
+			strs := make([]string, len(values))
+			for j, v := range values {
+				strs[j] = fmt.Sprintf("%v", v)
+			}
+			cells[i] = strings.Join(strs, ",")
+		}
+		fmt.Fprintf(w, "%s\n", strings.Join(cells, "\t"))
+	}
+	return nil
+}
+
 func tabbedString(f func(*tabwriter.Writer) error) (string, error) {
 	out := new(tabwriter.Writer)
 	b := make([]byte, 1024)
@@ -0,0 +1,141 @@
+/*
+Copyright 2014 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+func TestNamePrinter(t *testing.T) {
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
+	buf := &bytes.Buffer{}
+	if err := (&NamePrinter{}).PrintObj(pod, buf); err != nil {
+		t.Fatalf("PrintObj(single) returned error: %v", err)
+	}
+	if got, want := buf.String(), "pod/foo\n"; got != want {
+		t.Errorf("PrintObj(single) = %q, want %q", got, want)
+	}
+}
+
+func TestNamePrinterList(t *testing.T) {
+	list := &api.PodList{
+		Items: []api.Pod{
+			{ObjectMeta: api.ObjectMeta{Name: "foo"}},
+			{ObjectMeta: api.ObjectMeta{Name: "bar"}},
+		},
+	}
+	buf := &bytes.Buffer{}
+	if err := (&NamePrinter{}).PrintObj(list, buf); err != nil {
+		t.Fatalf("PrintObj(list) returned error: %v", err)
+	}
+	if got, want := buf.String(), "pod/foo\npod/bar\n"; got != want {
+		t.Errorf("PrintObj(list) = %q, want %q", got, want)
+	}
+}
+
+func TestNamePrinterEmptyList(t *testing.T) {
+	list := &api.PodList{}
+	buf := &bytes.Buffer{}
+	if err := (&NamePrinter{}).PrintObj(list, buf); err != nil {
+		t.Fatalf("PrintObj(empty list) returned error: %v", err)
+	}
+	if got, want := buf.String(), ""; got != want {
+		t.Errorf("PrintObj(empty list) = %q, want %q", got, want)
+	}
+}
+
+// unregisteredThing and unregisteredThingList stand in for a third-party or future API type
+// that HumanReadablePrinter has no handler for, exercising the printUnstructured fallback.
+type unregisteredThing struct {
+	api.ObjectMeta
+}
+
+type unregisteredThingList struct {
+	Items []unregisteredThing
+}
+
+func TestHumanReadablePrinterUnregisteredSingleton(t *testing.T) {
+	printer := NewHumanReadablePrinter(PrintOptions{})
+	obj := &unregisteredThing{ObjectMeta: api.ObjectMeta{Name: "widget"}}
+	buf := &bytes.Buffer{}
+	if err := printer.PrintObj(obj, buf); err != nil {
+		t.Fatalf("PrintObj returned error: %v", err)
+	}
+	want := "NAME\nwidget\n"
+	if got := buf.String(); got != want {
+		t.Errorf("PrintObj(unregistered singleton) = %q, want %q", got, want)
+	}
+}
+
+func TestHumanReadablePrinterUnregisteredSingletonWithNamespace(t *testing.T) {
+	printer := NewHumanReadablePrinter(PrintOptions{WithNamespace: true})
+	obj := &unregisteredThing{ObjectMeta: api.ObjectMeta{Name: "widget", Namespace: "default"}}
+	buf := &bytes.Buffer{}
+	if err := printer.PrintObj(obj, buf); err != nil {
+		t.Fatalf("PrintObj returned error: %v", err)
+	}
+	// tabwriter right-pads each column to its computed width rather than emitting literal tabs.
+	want := "NAMESPACE           NAME\ndefault             widget\n"
+	if got := buf.String(); got != want {
+		t.Errorf("PrintObj(unregistered singleton, WithNamespace) = %q, want %q", got, want)
+	}
+}
+
+func TestHumanReadablePrinterUnregisteredList(t *testing.T) {
+	printer := NewHumanReadablePrinter(PrintOptions{})
+	obj := &unregisteredThingList{
+		Items: []unregisteredThing{
+			{ObjectMeta: api.ObjectMeta{Name: "widget-1"}},
+			{ObjectMeta: api.ObjectMeta{Name: "widget-2"}},
+		},
+	}
+	buf := &bytes.Buffer{}
+	if err := printer.PrintObj(obj, buf); err != nil {
+		t.Fatalf("PrintObj returned error: %v", err)
+	}
+	want := "NAME\nwidget-1\nwidget-2\n"
+	if got := buf.String(); got != want {
+		t.Errorf("PrintObj(unregistered list) = %q, want %q", got, want)
+	}
+}
+
+// TestHumanReadablePrinterUnregisteredListSharesColumnWidths guards against a regression where
+// each item of an unregistered list was printed through its own tabwriter, so column widths were
+// computed per item instead of across the whole list -- unlike printPodList and printServiceList,
+// which share one tabwriter across all their rows. The first namespace is longer than the
+// tabwriter's minimum cell width of 20, so if the NAMESPACE column isn't shared, "ns" would be
+// padded to its own (shorter) width instead of lining up under "kube-system-monitoring".
+func TestHumanReadablePrinterUnregisteredListSharesColumnWidths(t *testing.T) {
+	printer := NewHumanReadablePrinter(PrintOptions{WithNamespace: true})
+	obj := &unregisteredThingList{
+		Items: []unregisteredThing{
+			{ObjectMeta: api.ObjectMeta{Name: "a", Namespace: "kube-system-monitoring"}},
+			{ObjectMeta: api.ObjectMeta{Name: "b", Namespace: "ns"}},
+		},
+	}
+	buf := &bytes.Buffer{}
+	if err := printer.PrintObj(obj, buf); err != nil {
+		t.Fatalf("PrintObj returned error: %v", err)
+	}
+	want := "NAMESPACE                NAME\nkube-system-monitoring   a\nns                       b\n"
+	if got := buf.String(); got != want {
+		t.Errorf("PrintObj(unregistered list) = %q, want %q", got, want)
+	}
+}